@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+)
+
+// CreditInfo 身份证号码解析结果.
+type CreditInfo struct {
+	Normalized    string    // 归一化后的18位号码(字母部分大写)
+	Province      string    // 省份/地区名称
+	ProvinceCode  int       // 省份/地区代码(号码前2位)
+	Birthday      time.Time // 出生日期
+	Age           int       // 周岁年龄,按当前日期计算
+	Gender        int       // 性别,1=男 2=女
+	IsCentenarian bool      // 顺序码是否为996/997/998/999(百岁以上老人专用编码)
+}
+
+// CreditAreas 身份证号码前2位地区代码表,11北京 ~ 91国外(含71台湾/81香港/82澳门).
+var CreditAreas = map[int]string{
+	11: "北京", 12: "天津", 13: "河北", 14: "山西", 15: "内蒙古",
+	21: "辽宁", 22: "吉林", 23: "黑龙江",
+	31: "上海", 32: "江苏", 33: "浙江", 34: "安徽", 35: "福建", 36: "江西", 37: "山东",
+	41: "河南", 42: "湖北", 43: "湖南", 44: "广东", 45: "广西", 46: "海南",
+	50: "重庆", 51: "四川", 52: "贵州", 53: "云南", 54: "西藏",
+	61: "陕西", 62: "甘肃", 63: "青海", 64: "宁夏", 65: "新疆",
+	71: "台湾", 81: "香港", 82: "澳门", 91: "国外",
+}
+
+// CreditNoProvince 返回身份证号码前2位对应的地区代码及名称,ok表示是否存在于CreditAreas.
+func (ts *TsStr) CreditNoProvince(str string) (code int, name string, ok bool) {
+	if len(str) < 2 {
+		return 0, "", false
+	}
+
+	code = TConv.ToInt(str[0:2])
+	name, ok = CreditAreas[code]
+	return code, name, ok
+}
+
+// CreditChecksum 计算18位身份证号码前17位对应的校验位(0-9或X).
+func (ts *TsStr) CreditChecksum(first17 string) byte {
+	return creditChecksum(first17)
+}
+
+// ParseCreditNo 解析(15或18位)身份证号码,返回归一化号码及省份、生日、年龄、性别等派生信息.
+func (ts *TsStr) ParseCreditNo(str string) (*CreditInfo, error) {
+	ok, normalized := ts.IsCreditNo(str)
+	if !ok {
+		return nil, fmt.Errorf("invalid credit no: %s", str)
+	}
+
+	code, name, _ := ts.CreditNoProvince(normalized)
+
+	birthdayStr := normalized[6:10] + "-" + normalized[10:12] + "-" + normalized[12:14]
+	_, tim := TTime.IsDate2time(birthdayStr)
+	birthday := time.Unix(tim, 0)
+
+	now := time.Now()
+	age := now.Year() - birthday.Year()
+	if now.Month() < birthday.Month() || (now.Month() == birthday.Month() && now.Day() < birthday.Day()) {
+		age--
+	}
+
+	genderDigit := int(normalized[16] - '0')
+	gender := 2
+	if genderDigit%2 != 0 {
+		gender = 1
+	}
+
+	seq := normalized[14:17]
+	isCentenarian := seq == "996" || seq == "997" || seq == "998" || seq == "999"
+
+	return &CreditInfo{
+		Normalized:    normalized,
+		Province:      name,
+		ProvinceCode:  code,
+		Birthday:      birthday,
+		Age:           age,
+		Gender:        gender,
+		IsCentenarian: isCentenarian,
+	}, nil
+}
+
+// IsCreditNoStrict 在IsCreditNo的基础上额外拒绝明显伪造的生日(早于1900年或晚于当前日期).
+func (ts *TsStr) IsCreditNoStrict(str string) (bool, string) {
+	ok, normalized := ts.IsCreditNo(str)
+	if !ok {
+		return false, ""
+	}
+
+	year := TConv.ToInt(normalized[6:10])
+	if year < 1900 || year > time.Now().Year() {
+		return false, ""
+	}
+
+	return true, normalized
+}
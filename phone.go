@@ -0,0 +1,154 @@
+package helper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PhoneInfo 手机/电话号码解析结果.
+type PhoneInfo struct {
+	E164           string // E.164格式,如+8613800138000
+	CountryCode    string // 国家代码,如86
+	NationalNumber string // 不含国家代码的号码
+	Region         string // 地区,如CN
+	IsMobile       bool   // 是否手机号(而非固话)
+	Carrier        string // 运营商(移动/联通/电信),仅CN地区手机号填充
+}
+
+// phoneRule 是某个地区的手机号/固话号正则规则对.
+type phoneRule struct {
+	mobile   *regexp.Regexp
+	landline *regexp.Regexp
+}
+
+// phoneCountryCode 是地区对应的E.164国家代码.
+var phoneCountryCode = map[string]string{
+	"CN": "86", "HK": "852", "MO": "853", "TW": "886",
+	"US": "1", "GB": "44", "JP": "81", "KR": "82", "IN": "91", "SG": "65",
+}
+
+// phoneRules 内置的地区手机号/固话号规则表,可通过RegisterPhoneRule追加或覆盖.
+var phoneRules = map[string]phoneRule{
+	"CN": {mobile: RegMobileCN, landline: RegTelephone},
+	"HK": {mobile: regexp.MustCompile(`^[569]\d{7}$`)},
+	"MO": {mobile: regexp.MustCompile(`^6\d{7}$`)},
+	"TW": {mobile: regexp.MustCompile(`^9\d{8}$`)},
+	"US": {mobile: regexp.MustCompile(`^[2-9]\d{9}$`)},
+	"GB": {mobile: regexp.MustCompile(`^7\d{9}$`)},
+	"JP": {mobile: regexp.MustCompile(`^[7-9]0\d{8}$`)},
+	"KR": {mobile: regexp.MustCompile(`^1[0-9]\d{7,8}$`)},
+	"IN": {mobile: regexp.MustCompile(`^[6-9]\d{9}$`)},
+	"SG": {mobile: regexp.MustCompile(`^[89]\d{7}$`)},
+}
+
+// RegE164 是未指定地区时回退使用的E.164号码格式正则.
+var RegE164 = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// mobileCarrierPrefixCN 是中国大陆手机号前3位对应的运营商.
+var mobileCarrierPrefixCN = map[string]string{
+	"134": "移动", "135": "移动", "136": "移动", "137": "移动", "138": "移动", "139": "移动",
+	"147": "移动", "150": "移动", "151": "移动", "152": "移动", "157": "移动", "158": "移动",
+	"159": "移动", "178": "移动", "182": "移动", "183": "移动", "184": "移动", "187": "移动", "188": "移动", "198": "移动",
+	"130": "联通", "131": "联通", "132": "联通", "145": "联通", "155": "联通", "156": "联通",
+	"166": "联通", "175": "联通", "176": "联通", "185": "联通", "186": "联通",
+	"133": "电信", "149": "电信", "153": "电信", "173": "电信", "177": "电信",
+	"180": "电信", "181": "电信", "189": "电信", "191": "电信", "199": "电信",
+}
+
+// RegisterPhoneRule 为region注册(或覆盖)手机号/固话号规则,landline可为nil.
+func RegisterPhoneRule(region string, mobile, landline *regexp.Regexp) {
+	phoneRules[strings.ToUpper(region)] = phoneRule{mobile: mobile, landline: landline}
+}
+
+// IsMobile 检查str是否region地区的合法手机号,region为空时按E.164格式校验.
+func (ts *TsStr) IsMobile(str, region string) bool {
+	if str == "" {
+		return false
+	}
+	if region == "" {
+		return RegE164.MatchString(str)
+	}
+
+	rule, ok := phoneRules[strings.ToUpper(region)]
+	return ok && rule.mobile != nil && rule.mobile.MatchString(str)
+}
+
+// IsPhoneRegion 检查str是否region地区的合法电话号码(手机或固话),region为空时按E.164格式校验.
+func (ts *TsStr) IsPhoneRegion(str, region string) bool {
+	if str == "" {
+		return false
+	}
+	if region == "" {
+		return RegE164.MatchString(str)
+	}
+
+	rule, ok := phoneRules[strings.ToUpper(region)]
+	if !ok {
+		return false
+	}
+
+	if rule.mobile != nil && rule.mobile.MatchString(str) {
+		return true
+	}
+	return rule.landline != nil && rule.landline.MatchString(str)
+}
+
+// ParsePhone 按defaultRegion解析str,返回E164、国家代码、地区、是否手机号等信息.
+func (ts *TsStr) ParsePhone(str, defaultRegion string) (*PhoneInfo, error) {
+	if str == "" {
+		return nil, fmt.Errorf("empty phone number")
+	}
+
+	if strings.HasPrefix(str, "+") {
+		if !RegE164.MatchString(str) {
+			return nil, fmt.Errorf("invalid e.164 phone number: %s", str)
+		}
+		for region, code := range phoneCountryCode {
+			if strings.HasPrefix(str[1:], code) {
+				national := str[1+len(code):]
+				var carrier string
+				if region == "CN" {
+					carrier, _ = ts.MobileCarrierCN(national)
+				}
+				return &PhoneInfo{
+					E164:           str,
+					CountryCode:    code,
+					NationalNumber: national,
+					Region:         region,
+					IsMobile:       ts.IsMobile(national, region),
+					Carrier:        carrier,
+				}, nil
+			}
+		}
+		return &PhoneInfo{E164: str, IsMobile: false}, nil
+	}
+
+	region := strings.ToUpper(defaultRegion)
+	if !ts.IsPhoneRegion(str, region) {
+		return nil, fmt.Errorf("invalid phone number for region %s: %s", region, str)
+	}
+
+	code := phoneCountryCode[region]
+	var carrier string
+	if region == "CN" {
+		carrier, _ = ts.MobileCarrierCN(str)
+	}
+	return &PhoneInfo{
+		E164:           "+" + code + str,
+		CountryCode:    code,
+		NationalNumber: str,
+		Region:         region,
+		IsMobile:       ts.IsMobile(str, region),
+		Carrier:        carrier,
+	}, nil
+}
+
+// MobileCarrierCN 返回中国大陆手机号对应的运营商(移动/联通/电信),str不是合法手机号或前缀未知时carrier为空且ok为false.
+func (ts *TsStr) MobileCarrierCN(str string) (carrier string, ok bool) {
+	if !ts.IsMobileCN(str) {
+		return "", false
+	}
+	carrier, ok = mobileCarrierPrefixCN[str[0:3]]
+	return carrier, ok
+}
@@ -0,0 +1,137 @@
+package helper
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// UrlCheckOptions 配置CheckUrl的探测行为.
+type UrlCheckOptions struct {
+	Timeout            time.Duration      // 单次请求超时时间,默认5秒
+	Method             string             // 请求方法,默认HEAD,405时自动降级为GET
+	FollowRedirects    bool               // 是否跟随重定向
+	MaxRedirects       int                // 最大重定向次数,默认10
+	AcceptStatus       func(int) bool     // 判定状态码是否视为存活,默认code < 400
+	UserAgent          string             // 自定义User-Agent
+	Headers            http.Header        // 附加请求头
+	InsecureSkipVerify bool               // 是否跳过TLS证书校验
+	Retries            int                // 失败重试次数,默认0
+}
+
+// UrlCheckResult 是CheckUrl的探测结果.
+type UrlCheckResult struct {
+	Alive         bool
+	StatusCode    int
+	FinalURL      string
+	Elapsed       time.Duration
+	RedirectChain []string
+}
+
+// urlCheckTransport 是CheckUrl共用的http.Transport,复用连接池避免每次调用都重新握手.
+var urlCheckTransport = &http.Transport{
+	MaxIdleConns:        100,
+	MaxIdleConnsPerHost: 10,
+	IdleConnTimeout:     90 * time.Second,
+}
+
+// CheckUrl 探测rawurl是否存活,相比IsUrlExists支持超时、重定向控制、自定义存活判定及重试.
+func (ts *TsStr) CheckUrl(ctx context.Context, rawurl string, opt *UrlCheckOptions) (*UrlCheckResult, error) {
+	if !ts.IsUrl(rawurl) {
+		return nil, fmt.Errorf("invalid url: %s", rawurl)
+	}
+
+	if opt == nil {
+		opt = &UrlCheckOptions{}
+	}
+	timeout := opt.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	method := opt.Method
+	if method == "" {
+		method = http.MethodHead
+	}
+	maxRedirects := opt.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = 10
+	}
+	acceptStatus := opt.AcceptStatus
+	if acceptStatus == nil {
+		acceptStatus = func(code int) bool { return code < 400 }
+	}
+
+	transport := urlCheckTransport
+	if opt.InsecureSkipVerify {
+		transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	var redirectChain []string
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			redirectChain = append(redirectChain, req.URL.String())
+			if !opt.FollowRedirects || len(via) > maxRedirects {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+
+	retries := opt.Retries
+	start := time.Now()
+
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			backoff := 200 * time.Millisecond * time.Duration(uint64(1)<<uint(attempt-1))
+			time.Sleep(backoff)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, rawurl, nil)
+		if err != nil {
+			return nil, err
+		}
+		if opt.UserAgent != "" {
+			req.Header.Set("User-Agent", opt.UserAgent)
+		}
+		for k, vs := range opt.Headers {
+			for _, v := range vs {
+				req.Header.Add(k, v)
+			}
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusMethodNotAllowed && method == http.MethodHead {
+			method = http.MethodGet
+			attempt--
+			continue
+		}
+
+		return &UrlCheckResult{
+			Alive:         acceptStatus(resp.StatusCode),
+			StatusCode:    resp.StatusCode,
+			FinalURL:      resp.Request.URL.String(),
+			Elapsed:       time.Since(start),
+			RedirectChain: redirectChain,
+		}, nil
+	}
+
+	return nil, lastErr
+}
+
+// IsUrlExists 检查URL是否存在.
+// Deprecated: 使用CheckUrl替代,该函数仅作为兼容垫片保留,固定使用5秒超时和默认选项.
+func (ts *TsStr) IsUrlExists(str string) bool {
+	res, err := ts.CheckUrl(context.Background(), str, &UrlCheckOptions{Timeout: 5 * time.Second})
+	return err == nil && res.Alive
+}
@@ -11,7 +11,6 @@ import (
 	"io/ioutil"
 	"math"
 	"net"
-	"net/http"
 	"net/url"
 	"os"
 	"reflect"
@@ -269,9 +268,9 @@ func (ts *TsStr) IsEmail(email string, validateHost bool) (bool, error) {
 	return true, nil
 }
 
-// IsMobileCN 检查字符串是否中国大陆手机号.
+// IsMobileCN 检查字符串是否中国大陆手机号,是IsMobile(str, "CN")的简写.
 func (ts *TsStr) IsMobileCN(str string) bool {
-	return str != "" && RegMobileCN.MatchString(str)
+	return ts.IsMobile(str, "CN")
 }
 
 // IsTel 是否固定电话或400/800电话.
@@ -292,7 +291,7 @@ func (ts *TsStr) IsCreditNo(str string) (bool, string) {
 	}
 
 	// 检查省份代码
-	if _, chk = CreditArea[str[0:2]]; !chk {
+	if _, _, chk = ts.CreditNoProvince(str); !chk {
 		return false, ""
 	}
 
@@ -443,23 +442,6 @@ func (ts *TsStr) IsUrl(str string) bool {
 	return true
 }
 
-// IsUrlExists 检查URL是否存在.
-func (ts *TsStr) IsUrlExists(str string) bool {
-	if !ts.IsUrl(str) {
-		return false
-	}
-
-	client := &http.Client{}
-	resp, err := client.Head(str)
-	if err != nil {
-		return false
-	} else if resp.StatusCode == 404 {
-		return false
-	}
-
-	return true
-}
-
 // IsMd5 是否md5值.
 func (ts *TsStr) IsMd5(str string) bool {
 	return str != "" && RegMd5.MatchString(str)
@@ -0,0 +1,306 @@
+package helper
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FieldError 描述结构体校验中单个规则的失败信息.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Value   interface{}
+	Message string
+}
+
+// Error 实现error接口,便于FieldError直接当错误使用.
+func (fe FieldError) Error() string {
+	if fe.Message != "" {
+		return fe.Message
+	}
+	return fmt.Sprintf("field %s failed on rule %s", fe.Field, fe.Rule)
+}
+
+// validatorFunc 是单条valid规则对应的校验函数,params为规则括号内以'|'分隔的参数.
+type validatorFunc func(value interface{}, params []string) (bool, string)
+
+// validatorRegistry 保存所有已注册的规则,内置规则和用户通过RegisterValidator追加的规则共用同一张表.
+var validatorRegistry = map[string]validatorFunc{
+	"required":  validateRequired,
+	"email":     validateEmailTag,
+	"ipv4":      validateIPv4Tag,
+	"url":       validateURLTag,
+	"mobilecn":  validateMobileCNTag,
+	"creditno":  validateCreditNoTag,
+	"range":     validateRangeTag,
+	"length":    validateLengthTag,
+	"matches":   validateMatchesTag,
+}
+
+// RegisterValidator 注册(或覆盖)一个valid标签规则,name为标签中使用的规则名,fn为具体校验逻辑.
+func RegisterValidator(name string, fn func(value interface{}, params []string) (bool, string)) {
+	validatorRegistry[name] = fn
+}
+
+// ValidateStruct 按字段的valid标签递归校验结构体v,返回是否全部通过及所有失败的FieldError.
+// 支持的标签形如: `valid:"required,email"` `valid:"range(0|100)"` `valid:"optional"` `valid:"-"`,
+// 自定义失败提示可搭配 `errorMsg:"..."` 标签.
+func (tc *TsConvert) ValidateStruct(v interface{}) (bool, []FieldError) {
+	var errs []FieldError
+	walkValidateStruct("", reflect.ValueOf(v), &errs)
+	return len(errs) == 0, errs
+}
+
+// MustValidateStruct 是ValidateStruct的panic版本,校验不通过时panic,否则直接返回(恒为空的)FieldError切片.
+func (tc *TsConvert) MustValidateStruct(v interface{}) []FieldError {
+	ok, errs := tc.ValidateStruct(v)
+	if !ok {
+		panic(fmt.Sprintf("helper: ValidateStruct failed: %v", errs))
+	}
+	return errs
+}
+
+// walkValidateStruct 递归遍历结构体(含嵌套结构体、指针、切片、数组、map)并对每个字段执行valid标签规则.
+func walkValidateStruct(prefix string, rv reflect.Value, errs *[]FieldError) {
+	rv = reflectPtr(rv)
+	if !rv.IsValid() {
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			walkValidateStruct(fmt.Sprintf("%s[%d]", prefix, i), rv.Index(i), errs)
+		}
+		return
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			walkValidateStruct(fmt.Sprintf("%s[%v]", prefix, key.Interface()), rv.MapIndex(key), errs)
+		}
+		return
+	case reflect.Struct:
+		// fall through to field-by-field validation below
+	default:
+		return
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			// 未导出字段,跳过
+			continue
+		}
+
+		fv := rv.Field(i)
+		name := sf.Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		tag, has := sf.Tag.Lookup("valid")
+		if sf.Anonymous && !has {
+			// 匿名嵌入字段默认也递归进去
+			walkValidateStruct(prefix, fv, errs)
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		if !has {
+			walkValidateStruct(name, fv, errs)
+			continue
+		}
+
+		validateField(name, fv, tag, sf.Tag.Get("errorMsg"), errs)
+		walkValidateStruct(name, fv, errs)
+	}
+}
+
+// validateField 解析单个字段的valid标签并依次运行各规则,遇到optional且值为空时跳过其余规则.
+func validateField(name string, fv reflect.Value, tag, customMsg string, errs *[]FieldError) {
+	rules := splitRuleTag(tag)
+
+	optional := false
+	for _, r := range rules {
+		if strings.TrimSpace(r) == "optional" {
+			optional = true
+			break
+		}
+	}
+
+	val := reflectInterface(fv)
+	if optional && TConv.IsEmpty(val) {
+		return
+	}
+
+	for _, r := range rules {
+		r = strings.TrimSpace(r)
+		if r == "" || r == "optional" || r == "-" {
+			continue
+		}
+
+		ruleName, params := parseRuleTag(r)
+		fn, ok := validatorRegistry[ruleName]
+		if !ok {
+			continue
+		}
+
+		if ok, msg := fn(val, params); !ok {
+			if customMsg != "" {
+				msg = customMsg
+			}
+			*errs = append(*errs, FieldError{Field: name, Rule: ruleName, Value: val, Message: msg})
+		}
+	}
+}
+
+// splitRuleTag 按','拆分valid标签中的多条规则,但不会在'('...')'内部拆分,
+// 使得 "matches(^\d{3,4}$)" 这类带逗号参数的规则不会被撕裂.
+func splitRuleTag(tag string) []string {
+	var rules []string
+	depth := 0
+	start := 0
+	for i, r := range tag {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				rules = append(rules, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	rules = append(rules, tag[start:])
+	return rules
+}
+
+// parseRuleTag 将形如 "range(0|100)" 的规则拆分为规则名和参数列表.
+func parseRuleTag(rule string) (name string, params []string) {
+	open := strings.IndexByte(rule, '(')
+	if open == -1 || !strings.HasSuffix(rule, ")") {
+		return rule, nil
+	}
+
+	name = rule[:open]
+	inner := rule[open+1 : len(rule)-1]
+	if inner == "" {
+		return name, nil
+	}
+
+	// matches 的参数是单个正则表达式,其本身可能含有'|'(如交替分支),不能按'|'拆分.
+	if name == "matches" {
+		return name, []string{inner}
+	}
+
+	return name, strings.Split(inner, "|")
+}
+
+// reflectInterface 将reflect.Value安全地还原为interface{},对无效值返回nil.
+func reflectInterface(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func validateRequired(value interface{}, _ []string) (bool, string) {
+	if TConv.IsEmpty(value) {
+		return false, "value is required"
+	}
+	return true, ""
+}
+
+func validateEmailTag(value interface{}, _ []string) (bool, string) {
+	str, _ := value.(string)
+	ok, err := TStr.IsEmail(str, false)
+	if !ok {
+		return false, fmt.Sprintf("invalid email: %v", err)
+	}
+	return true, ""
+}
+
+func validateIPv4Tag(value interface{}, _ []string) (bool, string) {
+	str, _ := value.(string)
+	if !TStr.IsIPv4(str) {
+		return false, "invalid ipv4 address"
+	}
+	return true, ""
+}
+
+func validateURLTag(value interface{}, _ []string) (bool, string) {
+	str, _ := value.(string)
+	if !TStr.IsUrl(str) {
+		return false, "invalid url"
+	}
+	return true, ""
+}
+
+func validateMobileCNTag(value interface{}, _ []string) (bool, string) {
+	str, _ := value.(string)
+	if !TStr.IsMobileCN(str) {
+		return false, "invalid mobile number"
+	}
+	return true, ""
+}
+
+func validateCreditNoTag(value interface{}, _ []string) (bool, string) {
+	str, _ := value.(string)
+	if ok, _ := TStr.IsCreditNo(str); !ok {
+		return false, "invalid credit no"
+	}
+	return true, ""
+}
+
+func validateRangeTag(value interface{}, params []string) (bool, string) {
+	if len(params) != 2 {
+		return false, "range rule requires 2 params"
+	}
+	left, err1 := strconv.Atoi(params[0])
+	right, err2 := strconv.Atoi(params[1])
+	if err1 != nil || err2 != nil {
+		return false, "range rule requires integer params"
+	}
+	if !TInt.IsRangeInt(TConv.ToInt(value), left, right) {
+		return false, fmt.Sprintf("value out of range [%d, %d]", left, right)
+	}
+	return true, ""
+}
+
+func validateLengthTag(value interface{}, params []string) (bool, string) {
+	if len(params) != 2 {
+		return false, "length rule requires 2 params"
+	}
+	min, err1 := strconv.Atoi(params[0])
+	max, err2 := strconv.Atoi(params[1])
+	if err1 != nil || err2 != nil {
+		return false, "length rule requires integer params"
+	}
+
+	str, _ := value.(string)
+	length := len([]rune(str))
+	if length < min || length > max {
+		return false, fmt.Sprintf("length must be between %d and %d", min, max)
+	}
+	return true, ""
+}
+
+func validateMatchesTag(value interface{}, params []string) (bool, string) {
+	if len(params) != 1 {
+		return false, "matches rule requires 1 param"
+	}
+	str, _ := value.(string)
+	re, err := regexp.Compile(params[0])
+	if err != nil || !re.MatchString(str) {
+		return false, fmt.Sprintf("value does not match %s", params[0])
+	}
+	return true, ""
+}
@@ -0,0 +1,190 @@
+package helper
+
+import (
+	"reflect"
+	"regexp"
+)
+
+// ValidationError 描述Validator链式校验中单条规则的失败信息.
+type ValidationError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// Error 实现error接口,便于ValidationError直接当错误使用.
+func (ve ValidationError) Error() string {
+	return ve.Message
+}
+
+// Validator 对单个值进行链式校验,累积所有失败而不是在第一个失败时中断.
+type Validator struct {
+	field  string
+	value  interface{}
+	errors []ValidationError
+}
+
+// NewValidator 创建一个Validator,field仅用于标识错误所属字段,value是待校验的值.
+func NewValidator(field string, value interface{}) *Validator {
+	return &Validator{field: field, value: value}
+}
+
+// Errors 返回目前为止累积的所有校验错误.
+func (v *Validator) Errors() []ValidationError {
+	return v.errors
+}
+
+// fail 记录一条规则失败.
+func (v *Validator) fail(rule, message string) {
+	v.errors = append(v.errors, ValidationError{Field: v.field, Rule: rule, Message: message})
+}
+
+// str 将value转换为string以供字符串类规则使用.
+func (v *Validator) str() string {
+	s, _ := v.value.(string)
+	return s
+}
+
+// Required 值不能为空(参照TsConvert.IsEmpty的语义).
+func (v *Validator) Required() *Validator {
+	if TConv.IsEmpty(v.value) {
+		v.fail("required", v.field+" is required")
+	}
+	return v
+}
+
+// NotBlank 字符串不能为空白字符.
+func (v *Validator) NotBlank() *Validator {
+	if TStr.IsBlank(v.str()) {
+		v.fail("notBlank", v.field+" must not be blank")
+	}
+	return v
+}
+
+// IP 值必须是合法IP地址.
+func (v *Validator) IP() *Validator {
+	if !TStr.IsIP(v.str()) {
+		v.fail("ip", v.field+" must be a valid ip address")
+	}
+	return v
+}
+
+// IPv4 值必须是合法IPv4地址.
+func (v *Validator) IPv4() *Validator {
+	if !TStr.IsIPv4(v.str()) {
+		v.fail("ipv4", v.field+" must be a valid ipv4 address")
+	}
+	return v
+}
+
+// URL 值必须是合法URL.
+func (v *Validator) URL() *Validator {
+	if !TStr.IsUrl(v.str()) {
+		v.fail("url", v.field+" must be a valid url")
+	}
+	return v
+}
+
+// Email 值必须是合法邮箱,validateHost指示是否校验邮箱主机.
+func (v *Validator) Email(validateHost bool) *Validator {
+	if ok, _ := TStr.IsEmail(v.str(), validateHost); !ok {
+		v.fail("email", v.field+" must be a valid email")
+	}
+	return v
+}
+
+// Mobile 值必须是region地区的合法手机号.
+func (v *Validator) Mobile(region string) *Validator {
+	if !TStr.IsMobile(v.str(), region) {
+		v.fail("mobile", v.field+" must be a valid mobile number")
+	}
+	return v
+}
+
+// CreditNo 值必须是合法身份证号码.
+func (v *Validator) CreditNo() *Validator {
+	if ok, _ := TStr.IsCreditNo(v.str()); !ok {
+		v.fail("creditNo", v.field+" must be a valid credit no")
+	}
+	return v
+}
+
+// In 值必须属于values之一.
+func (v *Validator) In(values ...interface{}) *Validator {
+	for _, candidate := range values {
+		if reflect.DeepEqual(candidate, v.value) {
+			return v
+		}
+	}
+	v.fail("in", v.field+" must be one of the allowed values")
+	return v
+}
+
+// Match 值必须匹配regex.
+func (v *Validator) Match(regex *regexp.Regexp) *Validator {
+	if regex == nil || !regex.MatchString(v.str()) {
+		v.fail("match", v.field+" must match the required pattern")
+	}
+	return v
+}
+
+// Range 值必须落在[lo, hi]区间内.
+func (v *Validator) Range(lo, hi float64) *Validator {
+	n := TConv.ToFloat64(v.value)
+	if !TFloat.IsRangeFloat64(n, lo, hi) {
+		v.fail("range", v.field+" must be between the given range")
+	}
+	return v
+}
+
+// Length 字符串长度必须落在[min, max]区间内.
+func (v *Validator) Length(min, max int) *Validator {
+	length := len([]rune(v.str()))
+	if length < min || length > max {
+		v.fail("length", v.field+" length must be between the given range")
+	}
+	return v
+}
+
+// MaxLen 字符串长度不能超过max.
+func (v *Validator) MaxLen(max int) *Validator {
+	if len([]rune(v.str())) > max {
+		v.fail("maxLen", v.field+" exceeds the maximum length")
+	}
+	return v
+}
+
+// JSON 值必须是合法JSON字符串.
+func (v *Validator) JSON() *Validator {
+	if !TStr.IsJSON(v.str()) {
+		v.fail("json", v.field+" must be valid json")
+	}
+	return v
+}
+
+// Base64 值必须是合法base64字符串.
+func (v *Validator) Base64() *Validator {
+	if !TStr.IsBase64(v.str()) {
+		v.fail("base64", v.field+" must be valid base64")
+	}
+	return v
+}
+
+// Custom 使用fn执行自定义规则,name用于标识错误来源.
+func (v *Validator) Custom(name string, fn func(interface{}) bool) *Validator {
+	if !fn(v.value) {
+		v.fail(name, v.field+" failed on custom rule "+name)
+	}
+	return v
+}
+
+// ValidateMap 按rules对payload(典型地来自解码后的JSON body)中的各字段执行校验,返回全部字段的错误汇总.
+func ValidateMap(payload map[string]interface{}, rules map[string]func(*Validator)) []ValidationError {
+	var errs []ValidationError
+	for field, configure := range rules {
+		v := NewValidator(field, payload[field])
+		configure(v)
+		errs = append(errs, v.Errors()...)
+	}
+	return errs
+}